@@ -0,0 +1,29 @@
+package database
+
+import "context"
+
+// contextKey is the type of keys used to store values in a context by
+// this package, preventing collisions with keys defined in other packages.
+type contextKey int
+
+const (
+	requestIDKey contextKey = iota
+)
+
+// ContextWithRequestID returns a new context carrying the specified request id.
+//
+// The request id may be used to correlate database operations with the
+// request (e.g. an HTTP request or trace span) that initiated them.
+func ContextWithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey, id)
+}
+
+// RequestIDFromContext returns the request id carried by the specified
+// context.  If the context does not carry a request id an empty string is
+// returned.
+func RequestIDFromContext(ctx context.Context) string {
+	if id, ok := ctx.Value(requestIDKey).(string); ok {
+		return id
+	}
+	return ""
+}
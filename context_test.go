@@ -0,0 +1,52 @@
+package database
+
+import (
+	"context"
+	"testing"
+)
+
+func TestRequestID(t *testing.T) {
+	testcases := []struct {
+		scenario string
+		exec     func(t *testing.T)
+	}{
+		{scenario: "round trip",
+			exec: func(t *testing.T) {
+				ctx := ContextWithRequestID(context.Background(), "request-1")
+
+				got := RequestIDFromContext(ctx)
+
+				if got != "request-1" {
+					t.Errorf("wanted %q, got %q", "request-1", got)
+				}
+			},
+		},
+		{scenario: "no request id",
+			exec: func(t *testing.T) {
+				got := RequestIDFromContext(context.Background())
+
+				if got != "" {
+					t.Errorf("wanted empty string, got %q", got)
+				}
+			},
+		},
+		{scenario: "child context inherits request id",
+			exec: func(t *testing.T) {
+				parent := ContextWithRequestID(context.Background(), "request-1")
+				ctx, cancel := context.WithCancel(parent)
+				defer cancel()
+
+				got := RequestIDFromContext(ctx)
+
+				if got != "request-1" {
+					t.Errorf("wanted %q, got %q", "request-1", got)
+				}
+			},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.scenario, func(t *testing.T) {
+			tc.exec(t)
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package database
+
+import "database/sql"
+
+// sentinel errors re-exported from database/sql so that callers may test
+// for them without importing database/sql
+var (
+	// ErrNoRows is sql.ErrNoRows, as returned by (*sql.Row).Scan when a
+	// query returns no rows.
+	ErrNoRows = sql.ErrNoRows
+
+	// ErrTransactionDone is sql.ErrTxDone, as returned by any operation
+	// performed on a transaction that has already been committed or
+	// rolled back.
+	ErrTransactionDone = sql.ErrTxDone
+)
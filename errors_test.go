@@ -0,0 +1,25 @@
+package database
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+)
+
+func TestSentinelErrors(t *testing.T) {
+	testcases := []struct {
+		name   string
+		err    error
+		target error
+	}{
+		{name: "ErrNoRows", err: ErrNoRows, target: sql.ErrNoRows},
+		{name: "ErrTransactionDone", err: ErrTransactionDone, target: sql.ErrTxDone},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			if !errors.Is(tc.err, tc.target) {
+				t.Errorf("wanted errors.Is(%v, %v) to be true", tc.err, tc.target)
+			}
+		})
+	}
+}
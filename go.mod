@@ -0,0 +1,3 @@
+module github.com/blugnu/database
+
+go 1.18
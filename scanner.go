@@ -0,0 +1,70 @@
+package database
+
+import "database/sql"
+
+// RowScanner is implemented by types that scan the current row of a
+// result set into a value of type T.
+type RowScanner[T any] interface {
+	ScanRow(rows *sql.Rows) (T, error)
+}
+
+// RowScannerFunc is an adapter allowing an ordinary function to be used
+// as a RowScanner.
+type RowScannerFunc[T any] func(rows *sql.Rows) (T, error)
+
+// ScanRow calls fn(rows).
+func (fn RowScannerFunc[T]) ScanRow(rows *sql.Rows) (T, error) {
+	return fn(rows)
+}
+
+// structScanner is a RowScanner that sets the fields of a T using a map
+// of column names to field setters.
+type structScanner[T any] struct {
+	fields map[string]func(*T, any)
+}
+
+// StructScanner returns a RowScanner that scans each row into a T without
+// the use of reflection.
+//
+// The fieldMap maps column names to functions that set the corresponding
+// field of the T from the scanned column value.  Columns in the result set
+// that are not in the map are ignored.
+//
+// Each column is scanned into an any, so a setter receives the value in
+// whatever form the driver returns it: one of int64, float64, bool,
+// []byte, string or time.Time, or nil for a NULL column.  Which of these
+// a given column type produces varies by driver (text columns in
+// particular may be returned as either []byte or string), so setters
+// should use a type switch or checked type assertion and must handle nil.
+func StructScanner[T any](fieldMap map[string]func(*T, any)) RowScanner[T] {
+	return structScanner[T]{fields: fieldMap}
+}
+
+// ScanRow scans the current row into a new T, calling the setter for each
+// mapped column with the value scanned for that column.
+func (s structScanner[T]) ScanRow(rows *sql.Rows) (T, error) {
+	var result T
+
+	cols, err := rows.Columns()
+	if err != nil {
+		return result, err
+	}
+
+	values := make([]any, len(cols))
+	dest := make([]any, len(cols))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+
+	if err := rows.Scan(dest...); err != nil {
+		return result, err
+	}
+
+	for i, col := range cols {
+		if set, ok := s.fields[col]; ok {
+			set(&result, values[i])
+		}
+	}
+
+	return result, nil
+}
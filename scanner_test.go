@@ -0,0 +1,195 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"testing"
+)
+
+// stubConnector is a driver.Connector whose connections return a fixed
+// result set for any query.
+type stubConnector struct {
+	columns []string
+	values  [][]driver.Value
+}
+
+func (c stubConnector) Connect(context.Context) (driver.Conn, error) { return stubConn(c), nil }
+func (c stubConnector) Driver() driver.Driver                        { return stubDriver{} }
+
+type stubDriver struct{}
+
+func (stubDriver) Open(string) (driver.Conn, error) { return nil, errors.New("not supported") }
+
+type stubConn stubConnector
+
+func (stubConn) Prepare(string) (driver.Stmt, error) { return nil, errors.New("not supported") }
+func (stubConn) Close() error                        { return nil }
+func (stubConn) Begin() (driver.Tx, error)           { return nil, errors.New("not supported") }
+
+func (c stubConn) QueryContext(context.Context, string, []driver.NamedValue) (driver.Rows, error) {
+	return &stubRows{columns: c.columns, values: c.values}, nil
+}
+
+type stubRows struct {
+	columns []string
+	values  [][]driver.Value
+}
+
+func (r *stubRows) Columns() []string { return r.columns }
+func (r *stubRows) Close() error      { return nil }
+
+func (r *stubRows) Next(dest []driver.Value) error {
+	if len(r.values) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.values[0])
+	r.values = r.values[1:]
+	return nil
+}
+
+// query returns the rows of a result set with the specified columns and
+// values, obtained from a stub database.
+func query(t *testing.T, columns []string, values ...[]driver.Value) *sql.Rows {
+	t.Helper()
+
+	db := sql.OpenDB(stubConnector{columns: columns, values: values})
+	t.Cleanup(func() { _ = db.Close() })
+
+	rows, err := db.Query("query")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	t.Cleanup(func() { _ = rows.Close() })
+
+	return rows
+}
+
+type person struct {
+	ID   int64
+	Name string
+}
+
+func TestStructScanner(t *testing.T) {
+	sut := StructScanner(map[string]func(*person, any){
+		"id": func(p *person, v any) { p.ID, _ = v.(int64) },
+		"name": func(p *person, v any) {
+			switch v := v.(type) {
+			case []byte:
+				p.Name = string(v)
+			case string:
+				p.Name = v
+			}
+		},
+	})
+
+	testcases := []struct {
+		scenario string
+		exec     func(t *testing.T)
+	}{
+		{scenario: "mapped columns",
+			exec: func(t *testing.T) {
+				rows := query(t, []string{"id", "name"}, []driver.Value{int64(1), "alice"})
+				rows.Next()
+
+				got, err := sut.ScanRow(rows)
+
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				if wanted := (person{ID: 1, Name: "alice"}); got != wanted {
+					t.Errorf("wanted %+v, got %+v", wanted, got)
+				}
+			},
+		},
+		{scenario: "null column",
+			exec: func(t *testing.T) {
+				rows := query(t, []string{"id", "name"}, []driver.Value{int64(1), nil})
+				rows.Next()
+
+				got, err := sut.ScanRow(rows)
+
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				if wanted := (person{ID: 1}); got != wanted {
+					t.Errorf("wanted %+v, got %+v", wanted, got)
+				}
+			},
+		},
+		{scenario: "unmapped columns are ignored",
+			exec: func(t *testing.T) {
+				rows := query(t, []string{"id", "email", "name"}, []driver.Value{int64(1), "alice@example.com", []byte("alice")})
+				rows.Next()
+
+				got, err := sut.ScanRow(rows)
+
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				if wanted := (person{ID: 1, Name: "alice"}); got != wanted {
+					t.Errorf("wanted %+v, got %+v", wanted, got)
+				}
+			},
+		},
+		{scenario: "columns error",
+			exec: func(t *testing.T) {
+				rows := query(t, []string{"id", "name"}, []driver.Value{int64(1), "alice"})
+				_ = rows.Close()
+
+				got, err := sut.ScanRow(rows)
+
+				if err == nil {
+					t.Error("wanted error, got nil")
+				}
+				if got != (person{}) {
+					t.Errorf("wanted zero value, got %+v", got)
+				}
+			},
+		},
+		{scenario: "scan error",
+			exec: func(t *testing.T) {
+				rows := query(t, []string{"id", "name"}, []driver.Value{int64(1), "alice"})
+
+				got, err := sut.ScanRow(rows) // Next not called
+
+				if err == nil {
+					t.Error("wanted error, got nil")
+				}
+				if got != (person{}) {
+					t.Errorf("wanted zero value, got %+v", got)
+				}
+			},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.scenario, func(t *testing.T) {
+			tc.exec(t)
+		})
+	}
+}
+
+func TestRowScannerFunc(t *testing.T) {
+	rows := query(t, []string{"id"})
+	scanerr := errors.New("scan error")
+
+	var called *sql.Rows
+	sut := RowScannerFunc[int](func(r *sql.Rows) (int, error) {
+		called = r
+		return 42, scanerr
+	})
+
+	got, err := sut.ScanRow(rows)
+
+	if called != rows {
+		t.Error("wanted wrapped function to be called with rows")
+	}
+	if got != 42 {
+		t.Errorf("wanted 42, got %d", got)
+	}
+	if !errors.Is(err, scanerr) {
+		t.Errorf("wanted %v, got %v", scanerr, err)
+	}
+}